@@ -0,0 +1,119 @@
+// Package benchmark implements the HTTP and DB workload simulations used to
+// compare this module's concurrency model against the PHP foroutines port.
+//
+// The RunXxxWorkload functions hold the actual b.RunParallel logic and are
+// exported so main.go can drive them programmatically via testing.Benchmark.
+// benchmark_test.go wraps each one in a BenchmarkXxxWorkload so the same
+// logic is also discoverable via `go test -bench .`.
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// cpuWorkSteps is how many iterations of the HTTPRequest/DBQuery CPU loops
+// run between ctx.Done() checks, so a canceled context bounds a job's
+// execution instead of merely being recorded as an error afterward.
+const cpuWorkSteps = 1000
+
+// HTTPRequest simulates a single outbound HTTP call: a randomized network
+// delay followed by a fixed amount of CPU work, mirroring the original
+// one-shot script's workload shape. It is exported so callers outside this
+// package (e.g. the worker pool in vosaka-foroutines/pool) can drive the same
+// workload job-by-job instead of through testing.B. It returns early with
+// whatever partial result it has if ctx is done before the work completes.
+func HTTPRequest(ctx context.Context) float64 {
+	delay := time.Duration(rand.Intn(400)+100) * time.Millisecond
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+		return 0
+	}
+
+	result := 0.0
+	for i := 0; i < 1000000; i++ {
+		if i%cpuWorkSteps == 0 {
+			select {
+			case <-ctx.Done():
+				return result
+			default:
+			}
+		}
+		result += math.Sqrt(float64(i))
+	}
+	return result
+}
+
+// DBQuery simulates a single database round-trip: a randomized query delay
+// followed by materializing a page of rows. Exported for the same reason as
+// HTTPRequest, and the same early-return-on-cancellation behavior applies.
+func DBQuery(ctx context.Context) []string {
+	delay := time.Duration(rand.Intn(150)+50) * time.Millisecond
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+		return nil
+	}
+
+	data := make([]string, 10000)
+	for i := 0; i < 10000; i++ {
+		if i%cpuWorkSteps == 0 {
+			select {
+			case <-ctx.Done():
+				return data[:i]
+			default:
+			}
+		}
+		data[i] = fmt.Sprintf("record_%d", i)
+	}
+	return data
+}
+
+// RunHTTPWorkload measures the cost of the simulated HTTP workload under
+// concurrent load. It scales with b.N and GOMAXPROCS via b.RunParallel, so
+// `-cpu` sweeps reflect real scheduler contention rather than a fixed
+// goroutine count.
+func RunHTTPWorkload(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			HTTPRequest(context.Background())
+		}
+	})
+}
+
+// RunDBWorkload measures the cost of the simulated DB workload under
+// concurrent load.
+func RunDBWorkload(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			DBQuery(context.Background())
+		}
+	})
+}
+
+// RunMixedWorkload interleaves the HTTP and DB workloads on each worker,
+// approximating the original script's combined 150-goroutine run.
+func RunMixedWorkload(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%2 == 0 {
+				HTTPRequest(context.Background())
+			} else {
+				DBQuery(context.Background())
+			}
+			i++
+		}
+	})
+}
@@ -0,0 +1,12 @@
+package benchmark
+
+import "testing"
+
+// BenchmarkHTTPWorkload runs RunHTTPWorkload under `go test -bench`.
+func BenchmarkHTTPWorkload(b *testing.B) { RunHTTPWorkload(b) }
+
+// BenchmarkDBWorkload runs RunDBWorkload under `go test -bench`.
+func BenchmarkDBWorkload(b *testing.B) { RunDBWorkload(b) }
+
+// BenchmarkMixedWorkload runs RunMixedWorkload under `go test -bench`.
+func BenchmarkMixedWorkload(b *testing.B) { RunMixedWorkload(b) }
@@ -0,0 +1,79 @@
+package benchmark
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// ContentionKeys is the number of keys pre-populated in the shared Store
+// used by the contention workload, large enough to avoid hot-key false
+// sharing while still fitting comfortably in memory.
+const ContentionKeys = 1_000_000
+
+// Store is a shared in-memory key/value map guarded by a RWMutex. Unlike the
+// HTTP/DB simulations, which allocate independent state per goroutine, Store
+// gives every worker the same shared state, so it stresses the scheduler and
+// memory model with real read/write contention instead of embarrassingly
+// parallel work.
+type Store struct {
+	mu   sync.RWMutex
+	data map[int]string
+	n    int // key count, fixed at construction; Access reads this instead of len(data) to avoid racing with concurrent Set calls
+}
+
+// NewStore returns a Store pre-populated with n keys.
+func NewStore(n int) *Store {
+	s := &Store{data: make(map[int]string, n), n: n}
+	for i := 0; i < n; i++ {
+		s.data[i] = fmt.Sprintf("value_%d", i)
+	}
+	return s
+}
+
+// Get reads the value for key.
+func (s *Store) Get(key int) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.data[key]
+	return v, ok
+}
+
+// Set writes value for key.
+func (s *Store) Set(key int, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] = value
+}
+
+// Access performs a single point read or point write against a random key
+// in s, chosen by rolling rng against readPercent (0-100): a roll below
+// readPercent is a read, otherwise a write. It reports which kind of access
+// it performed so callers can tally reads and writes separately.
+func (s *Store) Access(rng *rand.Rand, readPercent int) (isRead bool) {
+	key := rng.Intn(s.n)
+	if rng.Intn(100) < readPercent {
+		s.Get(key)
+		return true
+	}
+	s.Set(key, fmt.Sprintf("value_%d", key))
+	return false
+}
+
+// RunContention measures a 90:10 read/write mix against a shared Store under
+// concurrent load, surfacing scheduler and memory-model contention that
+// RunHTTPWorkload and RunDBWorkload, being embarrassingly parallel, cannot.
+// contention_test.go wraps it in BenchmarkContention for `go test -bench`.
+func RunContention(b *testing.B) {
+	store := NewStore(ContentionKeys)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		rng := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			store.Access(rng, 90)
+		}
+	})
+}
@@ -0,0 +1,6 @@
+package benchmark
+
+import "testing"
+
+// BenchmarkContention runs RunContention under `go test -bench`.
+func BenchmarkContention(b *testing.B) { RunContention(b) }
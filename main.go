@@ -0,0 +1,451 @@
+// Command vosaka-foroutines drives the benchmark package and prints a
+// summary comparable to the PHP foroutines port: wall-clock time, memory,
+// and per-workload ns/op, B/op, and allocs/op as reported by testing.B.
+//
+// By default it runs the simulated workloads (-mode=sim). Pass -mode=real
+// with one or more -url flags (or -urlfile) to instead fetch real URLs
+// concurrently and report their latency and byte counts. Pass -mode=pool
+// with -workload=http|db|mixed to drive an ab-style worker pool over -n
+// jobs with -c workers and a per-job -t timeout, reporting latency
+// percentiles. Pass -mode=contention with -ratio and -d to launch -c workers
+// against a shared in-memory store and report reads/sec and writes/sec. Pass
+// -mode=sweep to rerun -workload (including contention) at each GOMAXPROCS
+// value in -sweep-procs, emitting one report.Row per configuration in the
+// format chosen by -report (text, json, or csv) - the dataset this repo
+// commits under results/ for cross-runtime comparison with the PHP
+// foroutines port.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"vosaka-foroutines/benchmark"
+	"vosaka-foroutines/netfetch"
+	"vosaka-foroutines/pool"
+	"vosaka-foroutines/report"
+)
+
+// stringList collects repeated occurrences of a flag, e.g. -url a -url b.
+type stringList []string
+
+func (s *stringList) String() string { return fmt.Sprint([]string(*s)) }
+
+func (s *stringList) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+func main() {
+	mode := flag.String("mode", "sim", "benchmark mode: sim (simulated workloads), real (live HTTP fetches), pool (bounded worker pool), sweep (GOMAXPROCS sweep), or contention (shared-store read/write mix)")
+	urlFile := flag.String("urlfile", "", "path to a file of newline-separated URLs to fetch in -mode=real")
+	concurrency := flag.Int("c", 10, "max concurrent requests (-mode=real) or worker count (-mode=pool)")
+	var urls stringList
+	flag.Var(&urls, "url", "a URL to fetch in -mode=real (repeatable)")
+	numJobs := flag.Int("n", 100, "total number of jobs to run in -mode=pool")
+	jobTimeout := flag.Duration("t", 2*time.Second, "per-job timeout in -mode=pool")
+	workload := flag.String("workload", "mixed", "job type for -mode=pool (http, db, or mixed) or -mode=sweep (http, db, mixed, or contention)")
+	sweepProcs := flag.String("sweep-procs", "1,2,4,8", "comma-separated GOMAXPROCS values to sweep in -mode=sweep")
+	reportFormat := flag.String("report", "text", "output format for -mode=sweep: text, json, or csv")
+	ratio := flag.String("ratio", "90:10", "read:write ratio for -mode=contention (90:10, 50:50, or 10:90)")
+	duration := flag.Duration("d", 5*time.Second, "how long to run -mode=contention")
+	flag.Parse()
+
+	switch *mode {
+	case "real":
+		runRealMode(urls, *urlFile, *concurrency)
+	case "sim":
+		runSimMode()
+	case "pool":
+		runPoolMode(*numJobs, *concurrency, *jobTimeout, *workload)
+	case "sweep":
+		runSweepMode(*workload, *sweepProcs, *reportFormat, *numJobs, *concurrency, *jobTimeout, *duration, *ratio)
+	case "contention":
+		runContentionMode(*concurrency, *ratio, *duration)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown -mode %q: must be sim, real, pool, sweep, or contention\n", *mode)
+		os.Exit(1)
+	}
+}
+
+// runSimMode runs the simulated HTTP/DB workloads via testing.Benchmark and
+// prints the combined wall-clock, memory, and per-op summary.
+func runSimMode() {
+	fmt.Println("Starting Golang Concurrent Benchmark...")
+
+	startTime := time.Now()
+	var startMem runtime.MemStats
+	runtime.ReadMemStats(&startMem)
+
+	httpResult := testing.Benchmark(benchmark.RunHTTPWorkload)
+	dbResult := testing.Benchmark(benchmark.RunDBWorkload)
+	mixedResult := testing.Benchmark(benchmark.RunMixedWorkload)
+
+	endTime := time.Now()
+	var endMem runtime.MemStats
+	runtime.ReadMemStats(&endMem)
+
+	fmt.Println("\n=== Golang Results ===")
+	fmt.Printf("Total execution time: %.3f seconds\n", endTime.Sub(startTime).Seconds())
+	fmt.Printf("Memory used: %.2f MB\n", float64(endMem.Alloc-startMem.Alloc)/1024/1024)
+	fmt.Printf("Peak memory: %.2f MB\n", float64(endMem.Sys)/1024/1024)
+	fmt.Printf("Goroutines at end: %d\n", runtime.NumGoroutine())
+
+	printBenchmark("HTTP workload", httpResult)
+	printBenchmark("DB workload", dbResult)
+	printBenchmark("Mixed workload", mixedResult)
+}
+
+// runRealMode fetches urls (plus any URLs loaded from urlFile) concurrently
+// and reports per-URL latency, bytes, and the aggregate byte count. It exits
+// with a non-zero status if any fetch fails, since Fetch cancels the whole
+// run on the first error.
+func runRealMode(urls stringList, urlFile string, concurrency int) {
+	all := append([]string{}, urls...)
+	if urlFile != "" {
+		loaded, err := readURLFile(urlFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "reading -urlfile: %v\n", err)
+			os.Exit(1)
+		}
+		all = append(all, loaded...)
+	}
+	if len(all) == 0 {
+		fmt.Fprintln(os.Stderr, "-mode=real requires at least one -url or -urlfile")
+		os.Exit(1)
+	}
+
+	fmt.Printf("Fetching %d URL(s) with concurrency %d...\n", len(all), concurrency)
+
+	startTime := time.Now()
+	summary, err := netfetch.Fetch(context.Background(), all, concurrency)
+	elapsed := time.Since(startTime)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fetch failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("\n=== Real HTTP Results ===")
+	for _, r := range summary.Results {
+		fmt.Printf("%s: %d bytes in %s (status %d)\n", r.URL, r.Bytes, r.Latency, r.StatusCode)
+	}
+	fmt.Printf("Total execution time: %.3f seconds\n", elapsed.Seconds())
+	fmt.Printf("Total bytes: %d\n", summary.TotalBytes)
+}
+
+// runPoolMode drives n jobs of the given workload across a pool of workers
+// workers, each bounded by timeout, and prints total throughput alongside
+// p50/p90/p99/p999 latency - the same shape ab and wrk report, so runs are
+// directly comparable to those tools and to the PHP foroutines port.
+func runPoolMode(n, workers int, timeout time.Duration, workload string) {
+	if err := validatePositive("n", n); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := validatePositive("c", workers); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	makeJob, err := poolJobFor(workload)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Running %d %q jobs across %d workers (timeout %s)...\n", n, workload, workers, timeout)
+
+	startTime := time.Now()
+	rep, err := pool.Run(n, workers, timeout, makeJob)
+	elapsed := time.Since(startTime)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Println("\n=== Pool Results ===")
+	fmt.Printf("Total execution time: %.3f seconds\n", elapsed.Seconds())
+	fmt.Printf("Completed: %d, Errors: %d\n", len(rep.Latencies), rep.Errors)
+	fmt.Printf("p50: %s, p90: %s, p99: %s, p999: %s\n",
+		rep.Percentile(50), rep.Percentile(90), rep.Percentile(99), rep.Percentile(99.9))
+}
+
+// poolJobFor returns the pool.Job factory for the given workload name. Each
+// job is handed the pool's per-job context, so a job that overruns -t is
+// actually cut short rather than merely flagged as an error afterward.
+func poolJobFor(workload string) (func(i int) pool.Job, error) {
+	switch workload {
+	case "http":
+		return func(i int) pool.Job {
+			return func(ctx context.Context) error {
+				benchmark.HTTPRequest(ctx)
+				return nil
+			}
+		}, nil
+	case "db":
+		return func(i int) pool.Job {
+			return func(ctx context.Context) error {
+				benchmark.DBQuery(ctx)
+				return nil
+			}
+		}, nil
+	case "mixed":
+		return func(i int) pool.Job {
+			return func(ctx context.Context) error {
+				if i%2 == 0 {
+					benchmark.HTTPRequest(ctx)
+				} else {
+					benchmark.DBQuery(ctx)
+				}
+				return nil
+			}
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown -workload %q: must be http, db, or mixed", workload)
+	}
+}
+
+// benchmarkFuncFor returns the testing.B function for the given workload
+// name, for use with testing.Benchmark.
+func benchmarkFuncFor(workload string) (func(b *testing.B), error) {
+	switch workload {
+	case "http":
+		return benchmark.RunHTTPWorkload, nil
+	case "db":
+		return benchmark.RunDBWorkload, nil
+	case "mixed":
+		return benchmark.RunMixedWorkload, nil
+	case "contention":
+		return benchmark.RunContention, nil
+	default:
+		return nil, fmt.Errorf("unknown -workload %q: must be http, db, mixed, or contention", workload)
+	}
+}
+
+// runSweepMode reruns workload once per GOMAXPROCS value in sweepProcs,
+// recording ns/op and alloc bytes from testing.Benchmark for every row. For
+// the contention workload it additionally runs workers workers for
+// duration and records reads/sec and writes/sec at readPercent (from
+// ratio); every other workload instead runs a pool.Run of n jobs across
+// workers workers with the given timeout and records latency percentiles
+// and error counts. It restores the original GOMAXPROCS before returning and
+// writes the resulting rows to stdout in reportFormat.
+func runSweepMode(workload, sweepProcs, reportFormat string, n, workers int, timeout, duration time.Duration, ratio string) {
+	benchFunc, err := benchmarkFuncFor(workload)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	var makeJob func(i int) pool.Job
+	var readPercent int
+	if workload == "contention" {
+		readPercent, err = parseRatio(ratio)
+	} else {
+		makeJob, err = poolJobFor(workload)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	procs, err := parseProcsList(sweepProcs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := validatePositive("c", workers); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if workload != "contention" {
+		if err := validatePositive("n", n); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	original := runtime.GOMAXPROCS(0)
+	defer runtime.GOMAXPROCS(original)
+
+	rows := make([]report.Row, 0, len(procs))
+	for _, p := range procs {
+		runtime.GOMAXPROCS(p)
+		runtime.GC()
+
+		benchResult := testing.Benchmark(benchFunc)
+
+		row := report.Row{
+			Workload:   workload,
+			Goroutines: runtime.NumGoroutine(),
+			GOMAXPROCS: p,
+			NsPerOp:    float64(benchResult.NsPerOp()),
+			AllocBytes: int64(benchResult.AllocedBytesPerOp()),
+		}
+
+		if workload == "contention" {
+			reads, writes := runContentionOnce(workers, readPercent, duration)
+			row.ReadsPerSec = float64(reads) / duration.Seconds()
+			row.WritesPerSec = float64(writes) / duration.Seconds()
+		} else {
+			poolReport, err := pool.Run(n, workers, timeout, makeJob)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			row.P50Ms = poolReport.Percentile(50).Seconds() * 1000
+			row.P99Ms = poolReport.Percentile(99).Seconds() * 1000
+			row.Errors = poolReport.Errors
+		}
+
+		runtime.GC()
+		var mem runtime.MemStats
+		runtime.ReadMemStats(&mem)
+		row.PeakRSS = mem.Sys
+
+		rows = append(rows, row)
+	}
+
+	if err := report.Write(os.Stdout, reportFormat, rows); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// parseProcsList parses a comma-separated list of positive GOMAXPROCS
+// values, e.g. "1,2,4,8,16".
+func parseProcsList(s string) ([]int, error) {
+	parts := strings.Split(s, ",")
+	procs := make([]int, 0, len(parts))
+	for _, part := range parts {
+		p, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || p <= 0 {
+			return nil, fmt.Errorf("invalid -sweep-procs value %q: must be a positive integer", part)
+		}
+		procs = append(procs, p)
+	}
+	return procs, nil
+}
+
+// runContentionMode pre-populates a benchmark.Store and launches workers
+// concurrent workers that each perform point reads and writes against it,
+// split according to ratio, for duration. It reports reads/sec and
+// writes/sec separately so results can be plotted against GOMAXPROCS, the
+// way the column-store concurrency benchmark this workload is modeled on
+// does.
+func runContentionMode(workers int, ratio string, duration time.Duration) {
+	readPercent, err := parseRatio(ratio)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Running contention workload (%s) with %d workers for %s...\n", ratio, workers, duration)
+
+	reads, writes := runContentionOnce(workers, readPercent, duration)
+
+	fmt.Println("\n=== Contention Results ===")
+	fmt.Printf("GOMAXPROCS: %d\n", runtime.GOMAXPROCS(0))
+	fmt.Printf("reads/sec: %.0f\n", float64(reads)/duration.Seconds())
+	fmt.Printf("writes/sec: %.0f\n", float64(writes)/duration.Seconds())
+}
+
+// runContentionOnce pre-populates a benchmark.Store and launches workers
+// concurrent workers, each performing point reads and writes against it at
+// readPercent, for duration. It returns the total reads and writes
+// performed so callers - runContentionMode and runSweepMode - can report
+// throughput however fits their output.
+func runContentionOnce(workers, readPercent int, duration time.Duration) (reads, writes int64) {
+	store := benchmark.NewStore(benchmark.ContentionKeys)
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(rand.Int63()))
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if store.Access(rng, readPercent) {
+					atomic.AddInt64(&reads, 1)
+				} else {
+					atomic.AddInt64(&writes, 1)
+				}
+			}
+		}()
+	}
+
+	time.Sleep(duration)
+	close(stop)
+	wg.Wait()
+
+	return reads, writes
+}
+
+// validatePositive returns an error if v is less than 1, naming flagName in
+// the message so callers can report a usage error instead of letting v flow
+// into a make(chan ..., v) and panic, or into a loop bound and silently do
+// nothing.
+func validatePositive(flagName string, v int) error {
+	if v < 1 {
+		return fmt.Errorf("-%s must be >= 1, got %d", flagName, v)
+	}
+	return nil
+}
+
+// parseRatio parses a "read:write" ratio string, e.g. "90:10", into the read
+// percentage used by benchmark.Store.Access.
+func parseRatio(ratio string) (int, error) {
+	parts := strings.Split(ratio, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid -ratio %q: must be read:write, e.g. 90:10", ratio)
+	}
+	read, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
+	write, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err1 != nil || err2 != nil || read < 0 || write < 0 || read+write != 100 {
+		return 0, fmt.Errorf("invalid -ratio %q: must be read:write percentages summing to 100, e.g. 90:10", ratio)
+	}
+	return read, nil
+}
+
+// readURLFile reads newline-separated URLs from path, skipping blank lines.
+func readURLFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var urls []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		urls = append(urls, line)
+	}
+	return urls, scanner.Err()
+}
+
+// printBenchmark renders a testing.BenchmarkResult in the ns/op, B/op,
+// allocs/op form users expect from `go test -bench`.
+func printBenchmark(label string, r testing.BenchmarkResult) {
+	fmt.Printf("%s: %d iterations, %s\n", label, r.N, r.String())
+}
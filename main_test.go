@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestParseProcsList(t *testing.T) {
+	cases := []struct {
+		s       string
+		want    []int
+		wantErr bool
+	}{
+		{"1,2,4,8", []int{1, 2, 4, 8}, false},
+		{"1, 2, 4", []int{1, 2, 4}, false},
+		{"4", []int{4}, false},
+		{"0,2", nil, true},
+		{"-1,2", nil, true},
+		{"a,b", nil, true},
+		{"", nil, true},
+	}
+	for _, c := range cases {
+		got, err := parseProcsList(c.s)
+		if (err != nil) != c.wantErr {
+			t.Errorf("parseProcsList(%q) error = %v, wantErr %v", c.s, err, c.wantErr)
+			continue
+		}
+		if c.wantErr {
+			continue
+		}
+		if len(got) != len(c.want) {
+			t.Fatalf("parseProcsList(%q) = %v, want %v", c.s, got, c.want)
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("parseProcsList(%q)[%d] = %d, want %d", c.s, i, got[i], c.want[i])
+			}
+		}
+	}
+}
+
+func TestValidatePositive(t *testing.T) {
+	if err := validatePositive("n", 1); err != nil {
+		t.Errorf("validatePositive(1) = %v, want nil", err)
+	}
+	for _, v := range []int{0, -1, -100} {
+		if err := validatePositive("n", v); err == nil {
+			t.Errorf("validatePositive(%d) = nil, want error", v)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Package netfetch issues real, concurrent HTTP GET requests against a list
+// of URLs, as a counterpart to the simulated benchmark workloads in
+// vosaka-foroutines/benchmark. It follows the "return the error as soon as
+// possible" contract from the classic URL-sum interview task: the first
+// failing request cancels every other in-flight request.
+package netfetch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Result is the outcome of fetching a single URL.
+type Result struct {
+	URL        string
+	Bytes      int64
+	Latency    time.Duration
+	StatusCode int
+}
+
+// Summary aggregates the results of a Fetch run.
+type Summary struct {
+	Results    []Result
+	TotalBytes int64
+}
+
+// Fetch issues concurrent GET requests for urls, bounded by a semaphore of
+// size concurrency. The first error from any request - a transport error, or
+// an HTTP status >= 400 - cancels ctx and Fetch returns immediately with that
+// error; results still in flight are discarded.
+func Fetch(ctx context.Context, urls []string, concurrency int) (Summary, error) {
+	if concurrency < 1 {
+		return Summary{}, fmt.Errorf("concurrency must be >= 1, got %d", concurrency)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]Result, len(urls))
+	sem := make(chan struct{}, concurrency)
+	errOnce := make(chan error, 1)
+	var wg sync.WaitGroup
+
+	fail := func(err error) {
+		select {
+		case errOnce <- err:
+			cancel()
+		default:
+		}
+	}
+
+	for i, url := range urls {
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				fail(fmt.Errorf("%s: %w", url, err))
+				return
+			}
+
+			start := time.Now()
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				fail(fmt.Errorf("%s: %w", url, err))
+				return
+			}
+			defer resp.Body.Close()
+
+			n, err := io.Copy(io.Discard, resp.Body)
+			latency := time.Since(start)
+			if err != nil {
+				fail(fmt.Errorf("%s: %w", url, err))
+				return
+			}
+			if resp.StatusCode >= 400 {
+				fail(fmt.Errorf("%s: unexpected status %d", url, resp.StatusCode))
+				return
+			}
+
+			results[i] = Result{
+				URL:        url,
+				Bytes:      n,
+				Latency:    latency,
+				StatusCode: resp.StatusCode,
+			}
+		}(i, url)
+	}
+
+	wg.Wait()
+	close(errOnce)
+
+	if err, ok := <-errOnce; ok {
+		return Summary{}, err
+	}
+
+	var total int64
+	for _, r := range results {
+		total += r.Bytes
+	}
+	return Summary{Results: results, TotalBytes: total}, nil
+}
@@ -0,0 +1,69 @@
+package netfetch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFetchSuccess(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	urls := []string{srv.URL, srv.URL, srv.URL}
+	summary, err := Fetch(context.Background(), urls, 2)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if len(summary.Results) != len(urls) {
+		t.Fatalf("got %d results, want %d", len(summary.Results), len(urls))
+	}
+	if summary.TotalBytes != int64(len("hello")*len(urls)) {
+		t.Errorf("TotalBytes = %d, want %d", summary.TotalBytes, len("hello")*len(urls))
+	}
+}
+
+func TestFetchFailFastOnError(t *testing.T) {
+	var slowHits int32
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(2 * time.Second):
+		case <-r.Context().Done():
+		}
+		slowHits++
+	}))
+	defer slow.Close()
+
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer bad.Close()
+
+	urls := []string{slow.URL, bad.URL}
+	start := time.Now()
+	_, err := Fetch(context.Background(), urls, len(urls))
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("Fetch: want error, got nil")
+	}
+	if !strings.Contains(err.Error(), "unexpected status 500") {
+		t.Errorf("Fetch error = %q, want it to mention the 500 status", err.Error())
+	}
+	if elapsed > time.Second {
+		t.Errorf("Fetch took %v, want it to cancel the slow request well under 1s", elapsed)
+	}
+}
+
+func TestFetchInvalidConcurrency(t *testing.T) {
+	for _, c := range []int{-1, 0} {
+		if _, err := Fetch(context.Background(), []string{"http://example.com"}, c); err == nil {
+			t.Errorf("Fetch with concurrency=%d: want error, got nil", c)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Package pool implements a bounded worker pool: a fixed number of workers
+// pull jobs from a channel until n jobs have run, each bounded by a per-job
+// timeout. It records per-job latency so callers can report percentiles the
+// way ab/wrk do, making runs directly comparable to those tools.
+package pool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Job is a unit of work dispatched to a worker. A non-nil error, or running
+// past ctx's deadline, marks the job as failed; neither stops the pool. ctx
+// is only a real bound on a job's wall-clock time if the job itself checks
+// ctx.Done() - Run does not forcibly kill jobs that ignore it.
+type Job func(ctx context.Context) error
+
+// Report summarizes the outcome of a Run: every recorded job latency plus
+// the count of jobs that errored or timed out.
+type Report struct {
+	Latencies []time.Duration
+	Errors    int
+}
+
+// Percentile returns the latency at percentile p (0-100): the element at
+// index floor(p/100 * len), clamped to the last element, of a sorted copy of
+// the recorded latencies. It returns 0 if no latencies were recorded.
+func (r *Report) Percentile(p float64) time.Duration {
+	if len(r.Latencies) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), r.Latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	idx := int(p / 100 * float64(len(sorted)))
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// Run dispatches n jobs across workers goroutines, each bounded by timeout,
+// and returns a Report of per-job latency and error counts. makeJob builds
+// the job for index i lazily, so callers can vary the job by index - e.g. to
+// interleave workloads for a "mixed" run. It returns an error without
+// dispatching any jobs if n or workers is less than 1.
+func Run(n, workers int, timeout time.Duration, makeJob func(i int) Job) (*Report, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("n must be >= 1, got %d", n)
+	}
+	if workers < 1 {
+		return nil, fmt.Errorf("workers must be >= 1, got %d", workers)
+	}
+
+	indices := make(chan int, n)
+	for i := 0; i < n; i++ {
+		indices <- i
+	}
+	close(indices)
+
+	report := &Report{Latencies: make([]time.Duration, 0, n)}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				job := makeJob(i)
+
+				ctx, cancel := context.WithTimeout(context.Background(), timeout)
+				start := time.Now()
+				err := job(ctx)
+				latency := time.Since(start)
+
+				if err == nil {
+					err = ctx.Err()
+				}
+				cancel()
+
+				mu.Lock()
+				report.Latencies = append(report.Latencies, latency)
+				if err != nil {
+					report.Errors++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	return report, nil
+}
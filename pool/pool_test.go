@@ -0,0 +1,99 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRunInvalidArgs(t *testing.T) {
+	noop := func(i int) Job { return func(ctx context.Context) error { return nil } }
+
+	cases := []struct {
+		n, workers int
+	}{
+		{-1, 4},
+		{0, 4},
+		{4, -1},
+		{4, 0},
+	}
+	for _, c := range cases {
+		if _, err := Run(c.n, c.workers, time.Second, noop); err == nil {
+			t.Errorf("Run(n=%d, workers=%d): want error, got nil", c.n, c.workers)
+		}
+	}
+}
+
+func TestRunCollectsLatenciesAndErrors(t *testing.T) {
+	makeJob := func(i int) Job {
+		return func(ctx context.Context) error {
+			if i%2 == 0 {
+				return nil
+			}
+			return context.DeadlineExceeded
+		}
+	}
+
+	report, err := Run(10, 3, time.Second, makeJob)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(report.Latencies) != 10 {
+		t.Errorf("len(Latencies) = %d, want 10", len(report.Latencies))
+	}
+	if report.Errors != 5 {
+		t.Errorf("Errors = %d, want 5", report.Errors)
+	}
+}
+
+func TestRunRespectsJobDeadline(t *testing.T) {
+	makeJob := func(i int) Job {
+		return func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+	}
+
+	start := time.Now()
+	report, err := Run(1, 1, 20*time.Millisecond, makeJob)
+	elapsed := time.Since(start)
+
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if report.Errors != 1 {
+		t.Errorf("Errors = %d, want 1", report.Errors)
+	}
+	if elapsed > 500*time.Millisecond {
+		t.Errorf("Run took %v, want it bounded by the job's own ctx.Done() wait", elapsed)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	report := &Report{
+		Latencies: []time.Duration{
+			10 * time.Millisecond,
+			20 * time.Millisecond,
+			30 * time.Millisecond,
+			40 * time.Millisecond,
+			50 * time.Millisecond,
+		},
+	}
+
+	if got := report.Percentile(50); got != 30*time.Millisecond {
+		t.Errorf("Percentile(50) = %v, want 30ms", got)
+	}
+	if got := report.Percentile(99); got != 50*time.Millisecond {
+		t.Errorf("Percentile(99) = %v, want 50ms", got)
+	}
+	if got := report.Percentile(0); got != 10*time.Millisecond {
+		t.Errorf("Percentile(0) = %v, want 10ms", got)
+	}
+}
+
+func TestPercentileEmpty(t *testing.T) {
+	report := &Report{}
+	if got := report.Percentile(50); got != 0 {
+		t.Errorf("Percentile(50) on empty report = %v, want 0", got)
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import "testing"
+
+func TestParseRatio(t *testing.T) {
+	cases := []struct {
+		ratio   string
+		want    int
+		wantErr bool
+	}{
+		{"90:10", 90, false},
+		{"0:100", 0, false},
+		{"100:0", 100, false},
+		{"50:50", 50, false},
+		{"90:20", 0, true},
+		{"abc:10", 0, true},
+		{"90", 0, true},
+		{"-10:110", 0, true},
+	}
+	for _, c := range cases {
+		got, err := parseRatio(c.ratio)
+		if (err != nil) != c.wantErr {
+			t.Errorf("parseRatio(%q) error = %v, wantErr %v", c.ratio, err, c.wantErr)
+			continue
+		}
+		if !c.wantErr && got != c.want {
+			t.Errorf("parseRatio(%q) = %d, want %d", c.ratio, got, c.want)
+		}
+	}
+}
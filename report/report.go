@@ -0,0 +1,98 @@
+// Package report defines the machine-readable result schema emitted by the
+// -mode=sweep GOMAXPROCS sweep, and writers for its json/csv/text encodings.
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Row is one measurement of a workload at a particular GOMAXPROCS setting,
+// shaped for plotting goroutine cost vs. thread count and for cross-runtime
+// comparison with the PHP foroutines port. ReadsPerSec and WritesPerSec are
+// only populated for the contention workload, which reports throughput
+// rather than per-job latency; they're 0 for every other workload.
+type Row struct {
+	Workload     string  `json:"workload"`
+	Goroutines   int     `json:"goroutines"`
+	GOMAXPROCS   int     `json:"gomaxprocs"`
+	NsPerOp      float64 `json:"ns_per_op"`
+	AllocBytes   int64   `json:"alloc_bytes"`
+	PeakRSS      uint64  `json:"peak_rss"`
+	P50Ms        float64 `json:"p50_ms"`
+	P99Ms        float64 `json:"p99_ms"`
+	Errors       int     `json:"errors"`
+	ReadsPerSec  float64 `json:"reads_per_sec"`
+	WritesPerSec float64 `json:"writes_per_sec"`
+}
+
+var csvHeader = []string{
+	"workload", "goroutines", "gomaxprocs", "ns_per_op",
+	"alloc_bytes", "peak_rss", "p50_ms", "p99_ms", "errors",
+	"reads_per_sec", "writes_per_sec",
+}
+
+// WriteJSON writes rows to w as an indented JSON array.
+func WriteJSON(w io.Writer, rows []Row) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(rows)
+}
+
+// WriteCSV writes rows to w as CSV, with a header row matching csvHeader.
+func WriteCSV(w io.Writer, rows []Row) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		record := []string{
+			r.Workload,
+			strconv.Itoa(r.Goroutines),
+			strconv.Itoa(r.GOMAXPROCS),
+			strconv.FormatFloat(r.NsPerOp, 'f', -1, 64),
+			strconv.FormatInt(r.AllocBytes, 10),
+			strconv.FormatUint(r.PeakRSS, 10),
+			strconv.FormatFloat(r.P50Ms, 'f', -1, 64),
+			strconv.FormatFloat(r.P99Ms, 'f', -1, 64),
+			strconv.Itoa(r.Errors),
+			strconv.FormatFloat(r.ReadsPerSec, 'f', -1, 64),
+			strconv.FormatFloat(r.WritesPerSec, 'f', -1, 64),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteText writes rows to w as one human-readable summary line each.
+func WriteText(w io.Writer, rows []Row) error {
+	for _, r := range rows {
+		_, err := fmt.Fprintf(w, "%s gomaxprocs=%d ns/op=%.1f alloc_bytes=%d peak_rss=%d p50=%.2fms p99=%.2fms errors=%d reads/sec=%.0f writes/sec=%.0f\n",
+			r.Workload, r.GOMAXPROCS, r.NsPerOp, r.AllocBytes, r.PeakRSS, r.P50Ms, r.P99Ms, r.Errors, r.ReadsPerSec, r.WritesPerSec)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Write dispatches to WriteJSON, WriteCSV, or WriteText based on format,
+// returning an error for any other value.
+func Write(w io.Writer, format string, rows []Row) error {
+	switch format {
+	case "json":
+		return WriteJSON(w, rows)
+	case "csv":
+		return WriteCSV(w, rows)
+	case "text":
+		return WriteText(w, rows)
+	default:
+		return fmt.Errorf("unknown report format %q: must be json, csv, or text", format)
+	}
+}
@@ -0,0 +1,99 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func sampleRows() []Row {
+	return []Row{
+		{
+			Workload:   "http",
+			Goroutines: 100,
+			GOMAXPROCS: 4,
+			NsPerOp:    1234.5,
+			AllocBytes: 256,
+			PeakRSS:    1024,
+			P50Ms:      12.5,
+			P99Ms:      45.0,
+			Errors:     1,
+		},
+		{
+			Workload:     "contention",
+			Goroutines:   50,
+			GOMAXPROCS:   8,
+			ReadsPerSec:  1000.0,
+			WritesPerSec: 200.0,
+		},
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, sampleRows()); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	var got []Row
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d rows, want 2", len(got))
+	}
+	if got[0].Workload != "http" || got[0].Errors != 1 {
+		t.Errorf("row 0 = %+v, want workload=http errors=1", got[0])
+	}
+	if got[1].ReadsPerSec != 1000.0 {
+		t.Errorf("row 1 ReadsPerSec = %v, want 1000", got[1].ReadsPerSec)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, sampleRows()); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3 (header + 2 rows)", len(lines))
+	}
+	if lines[0] != strings.Join(csvHeader, ",") {
+		t.Errorf("header = %q, want %q", lines[0], strings.Join(csvHeader, ","))
+	}
+	if !strings.HasPrefix(lines[1], "http,100,4,1234.5,256,1024,12.5,45,1,0,0") {
+		t.Errorf("row 1 = %q, unexpected format", lines[1])
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteText(&buf, sampleRows()); err != nil {
+		t.Fatalf("WriteText: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "http") || !strings.Contains(out, "contention") {
+		t.Errorf("WriteText output missing expected workloads: %q", out)
+	}
+}
+
+func TestWriteDispatch(t *testing.T) {
+	rows := sampleRows()
+	for _, format := range []string{"json", "csv", "text"} {
+		var buf bytes.Buffer
+		if err := Write(&buf, format, rows); err != nil {
+			t.Errorf("Write(format=%q): %v", format, err)
+		}
+		if buf.Len() == 0 {
+			t.Errorf("Write(format=%q): empty output", format)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "xml", rows); err == nil {
+		t.Error("Write(format=\"xml\"): want error, got nil")
+	}
+}